@@ -0,0 +1,38 @@
+package fib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigN calculates the nth Fibonacci number using math/big, so it never
+// overflows regardless of how large n is. Prefer fibonacci (or Generator)
+// for small n, where native int arithmetic is faster; BigN becomes
+// necessary once n exceeds 92, since F(93) overflows a 64-bit int.
+func BigN(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative index")
+	}
+
+	if n <= 1 {
+		return big.NewInt(int64(n)), nil
+	}
+
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 2; i <= n; i++ {
+		a, b = b, new(big.Int).Add(a, b)
+	}
+
+	return b, nil
+}
+
+// BigGenerator returns a stateful closure yielding F(0), F(1), F(2), … as
+// *big.Int on each call, unbounded by int overflow.
+func BigGenerator() func() *big.Int {
+	a, b := big.NewInt(0), big.NewInt(1)
+	return func() *big.Int {
+		next := a
+		a, b = b, new(big.Int).Add(a, b)
+		return next
+	}
+}
@@ -0,0 +1,62 @@
+package fib
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigN(t *testing.T) {
+	cases := []struct {
+		n    int
+		want int64
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 1},
+		{10, 55},
+		{20, 6765},
+	}
+
+	for _, c := range cases {
+		got, err := BigN(c.n)
+		if err != nil {
+			t.Fatalf("BigN(%d): unexpected error: %v", c.n, err)
+		}
+		if got.Cmp(big.NewInt(c.want)) != 0 {
+			t.Errorf("BigN(%d) = %s, want %d", c.n, got.String(), c.want)
+		}
+	}
+
+	if _, err := BigN(-1); err == nil {
+		t.Error("BigN(-1) = nil error, want error")
+	}
+}
+
+func TestBigGenerator(t *testing.T) {
+	gen := BigGenerator()
+
+	want := []int64{0, 1, 1, 2, 3, 5, 8}
+	for i, w := range want {
+		got := gen()
+		if got.Cmp(big.NewInt(w)) != 0 {
+			t.Errorf("call %d = %s, want %d", i, got.String(), w)
+		}
+	}
+}
+
+func TestBigNLargeN(t *testing.T) {
+	// F(100) overflows int64 (and int on 64-bit platforms), demonstrating
+	// BigN's advantage over the native-int variant.
+	got, err := BigN(100)
+	if err != nil {
+		t.Fatalf("BigN(100): unexpected error: %v", err)
+	}
+
+	want, ok := new(big.Int).SetString("354224848179261915075", 10)
+	if !ok {
+		t.Fatal("failed to parse expected value")
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("BigN(100) = %s, want %s", got.String(), want.String())
+	}
+}
@@ -0,0 +1,22 @@
+// Command fibd serves the fib package's Fibonacci calculations over HTTP.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/infer-no-dev/devkit/fibhttp"
+)
+
+func main() {
+	port := flag.String("port", "8080", "port to listen on")
+	maxN := flag.Int("max-n", 1_000_000, "largest n a /fib/{n} request may ask for")
+	maxSeqN := flag.Int("max-seq-n", 10_000, "largest n a /fib/seq request may ask for")
+	flag.Parse()
+
+	handler := fibhttp.NewHandler(fibhttp.WithMaxN(*maxN), fibhttp.WithMaxSeqN(*maxSeqN))
+
+	log.Printf("fibd listening on :%s", *port)
+	log.Fatal(http.ListenAndServe(":"+*port, handler))
+}
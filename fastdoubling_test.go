@@ -0,0 +1,48 @@
+package fib
+
+import "testing"
+
+func TestFastNAgainstBigN(t *testing.T) {
+	// Exercise n=0, n=1, and an odd/even pair straddling a power-of-two
+	// boundary, where the fast-doubling parity branch is most likely to
+	// drift off by one bit.
+	ns := []int{0, 1, 2, 3, 10, 63, 64, 65, 127, 128, 129, 500}
+
+	for _, n := range ns {
+		want, err := BigN(n)
+		if err != nil {
+			t.Fatalf("BigN(%d): unexpected error: %v", n, err)
+		}
+
+		got, err := FastN(n)
+		if err != nil {
+			t.Fatalf("FastN(%d): unexpected error: %v", n, err)
+		}
+
+		if got.Cmp(want) != 0 {
+			t.Errorf("FastN(%d) = %s, want %s (BigN)", n, got.String(), want.String())
+		}
+	}
+}
+
+func TestFastNNegative(t *testing.T) {
+	if _, err := FastN(-1); err == nil {
+		t.Error("FastN(-1) = nil error, want error")
+	}
+}
+
+func BenchmarkBigNLinear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := BigN(50000); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFastNDoubling(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := FastN(50000); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
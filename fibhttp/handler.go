@@ -0,0 +1,142 @@
+// Package fibhttp exposes the fib package's Fibonacci calculations as an
+// HTTP service.
+package fibhttp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/infer-no-dev/devkit"
+)
+
+// termResponse is the JSON shape returned by GET /fib/{n}.
+type termResponse struct {
+	N     int    `json:"n"`
+	Value string `json:"value"`
+}
+
+// seqResponse is the JSON shape returned by GET /fib/seq.
+type seqResponse struct {
+	N      int      `json:"n"`
+	Values []string `json:"values"`
+}
+
+// NewHandler returns an http.Handler serving:
+//
+//	GET /fib/{n}      -> {"n": N, "value": "..."}
+//	GET /fib/seq?n=N  -> {"n": N, "values": ["...", ...]}
+//
+// Both routes honor the Accept header: "text/plain" returns a bare value
+// (or newline-separated values for /fib/seq), anything else returns JSON.
+func NewHandler(opts ...Option) http.Handler {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fib/seq", o.handleSeq)
+	mux.HandleFunc("/fib/", o.handleTerm)
+	return mux
+}
+
+// handleTerm serves /fib/{n} via fib.FastN, whose O(log n) big.Int
+// arithmetic stays well within the request timeout for any n up to maxN.
+func (o options) handleTerm(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), o.timeout)
+	defer cancel()
+
+	n, err := parseN(strings.TrimPrefix(r.URL.Path, "/fib/"), o.maxN)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if ctx.Err() != nil {
+		http.Error(w, "request timed out", http.StatusGatewayTimeout)
+		return
+	}
+
+	v, err := fib.FastN(n)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeTerm(w, r, termResponse{N: n, Value: v.String()})
+}
+
+// handleSeq serves /fib/seq by walking fib.BigGenerator one term at a time,
+// checking ctx between terms so a timed-out request stops computing (and
+// allocating) instead of running to completion after the client has moved
+// on.
+func (o options) handleSeq(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), o.timeout)
+	defer cancel()
+
+	n, err := parseN(r.URL.Query().Get("n"), o.maxSeqN)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	gen := fib.BigGenerator()
+	values := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			http.Error(w, "request timed out", http.StatusGatewayTimeout)
+			return
+		default:
+		}
+		values = append(values, gen().String())
+	}
+
+	writeSeq(w, r, seqResponse{N: n, Values: values})
+}
+
+func parseN(raw string, maxN int) (int, error) {
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid n: %q", raw)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("n must be non-negative")
+	}
+	if n > maxN {
+		return 0, fmt.Errorf("n exceeds maximum of %d", maxN)
+	}
+	return n, nil
+}
+
+func wantsPlainText(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/plain")
+}
+
+func writeTerm(w http.ResponseWriter, r *http.Request, resp termResponse) {
+	if wantsPlainText(r) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, resp.Value)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeSeq(w http.ResponseWriter, r *http.Request, resp seqResponse) {
+	if wantsPlainText(r) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for _, v := range resp.Values {
+			fmt.Fprintln(w, v)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
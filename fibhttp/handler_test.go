@@ -0,0 +1,119 @@
+package fibhttp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandlerTermJSON(t *testing.T) {
+	srv := httptest.NewServer(NewHandler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/fib/10")
+	if err != nil {
+		t.Fatalf("GET /fib/10: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var got termResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.N != 10 || got.Value != "55" {
+		t.Errorf("got %+v, want {N:10 Value:55}", got)
+	}
+}
+
+func TestHandlerTermPlainText(t *testing.T) {
+	srv := httptest.NewServer(NewHandler())
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/fib/10", nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /fib/10: %v", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if got := strings.TrimSpace(string(raw)); got != "55" {
+		t.Errorf("body = %q, want %q", got, "55")
+	}
+}
+
+func TestHandlerSeq(t *testing.T) {
+	srv := httptest.NewServer(NewHandler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/fib/seq?n=5")
+	if err != nil {
+		t.Fatalf("GET /fib/seq?n=5: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var got seqResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	want := []string{"0", "1", "1", "2", "3"}
+	if got.N != 5 || len(got.Values) != len(want) {
+		t.Fatalf("got %+v, want N:5 Values:%v", got, want)
+	}
+	for i, v := range want {
+		if got.Values[i] != v {
+			t.Errorf("Values[%d] = %s, want %s", i, got.Values[i], v)
+		}
+	}
+}
+
+func TestHandlerMaxNRejected(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(WithMaxN(100)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/fib/101")
+	if err != nil {
+		t.Fatalf("GET /fib/101: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestHandlerTimeout(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(WithTimeout(time.Nanosecond)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/fib/10")
+	if err != nil {
+		t.Fatalf("GET /fib/10: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusGatewayTimeout)
+	}
+}
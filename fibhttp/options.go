@@ -0,0 +1,64 @@
+package fibhttp
+
+import "time"
+
+const (
+	// defaultMaxN caps the n a single /fib/{n} request may ask for. It's
+	// served via fib.FastN, whose O(log n) big.Int arithmetic stays cheap
+	// even at this bound (well under a second).
+	defaultMaxN = 1_000_000
+
+	// defaultMaxSeqN caps the n a /fib/seq request may ask for. Unlike
+	// /fib/{n}, this route retains every term it computes, and those terms
+	// grow linearly in bit length, so total memory is O(n²); this default
+	// keeps worst-case usage in the low tens of megabytes.
+	defaultMaxSeqN = 10_000
+
+	// defaultTimeout bounds how long a single request may take to compute.
+	defaultTimeout = 5 * time.Second
+)
+
+// options holds the configuration assembled from a NewHandler call's
+// Option values.
+type options struct {
+	maxN    int
+	maxSeqN int
+	timeout time.Duration
+}
+
+func defaultOptions() options {
+	return options{
+		maxN:    defaultMaxN,
+		maxSeqN: defaultMaxSeqN,
+		timeout: defaultTimeout,
+	}
+}
+
+// Option configures the handler returned by NewHandler.
+type Option func(*options)
+
+// WithMaxN sets the largest n a /fib/{n} request may ask for. Requests for
+// a larger n are rejected with 400 Bad Request. It defaults to 1,000,000.
+func WithMaxN(n int) Option {
+	return func(o *options) {
+		o.maxN = n
+	}
+}
+
+// WithMaxSeqN sets the largest n a /fib/seq request may ask for. Requests
+// for a larger n are rejected with 400 Bad Request. It defaults to 10,000.
+func WithMaxSeqN(n int) Option {
+	return func(o *options) {
+		o.maxSeqN = n
+	}
+}
+
+// WithTimeout sets the per-request deadline, after which the handler
+// responds with 504 Gateway Timeout. /fib/seq checks it between terms;
+// /fib/{n} checks it only before calling fib.FastN, and a computation that
+// has started runs to completion. It defaults to 5s.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
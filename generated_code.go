@@ -17,9 +17,8 @@ func fibonacci(n int) (int, error) {
 
 	a, b := 0, 1
 	for i := 2; i <= n; i++ {
-		tmp := a + b
-		a, b = b, tmp
+		a, b = b, a+b
 	}
 
-	return tmp, nil
+	return b, nil
 }
\ No newline at end of file
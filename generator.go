@@ -0,0 +1,51 @@
+package fib
+
+import (
+	"fmt"
+	"math"
+)
+
+// Generator produces successive terms of the Fibonacci sequence and can be
+// rewound back to F(0) without allocating a new closure.
+type Generator struct {
+	a, b     int
+	aInvalid bool
+	bInvalid bool
+}
+
+// NewGeneratorState returns a Generator positioned at F(0).
+func NewGeneratorState() *Generator {
+	return &Generator{a: 0, b: 1}
+}
+
+// Next returns the next term in the sequence, starting at F(0), F(1), F(2), …
+// It returns an error once the term itself would overflow int; terms up to
+// and including the last one that fits continue to be returned correctly.
+func (g *Generator) Next() (int, error) {
+	if g.aInvalid {
+		return 0, fmt.Errorf("fib: generator overflowed int at next term")
+	}
+
+	next := g.a
+	newB := g.a + g.b
+	newBInvalid := g.a > math.MaxInt-g.b
+
+	g.a, g.aInvalid = g.b, g.bInvalid
+	g.b, g.bInvalid = newB, newBInvalid
+
+	return next, nil
+}
+
+// Reset rewinds the generator back to F(0).
+func (g *Generator) Reset() {
+	g.a, g.b = 0, 1
+	g.aInvalid, g.bInvalid = false, false
+}
+
+// NewGenerator returns a stateful closure yielding F(0), F(1), F(2), … on
+// each call. It returns an error once the next term would overflow int,
+// so callers can terminate cleanly.
+func NewGenerator() func() (int, error) {
+	g := NewGeneratorState()
+	return g.Next
+}
@@ -0,0 +1,55 @@
+package fib
+
+import "testing"
+
+func TestGeneratorNext(t *testing.T) {
+	gen := NewGenerator()
+
+	want := []int{0, 1, 1, 2, 3, 5, 8}
+	for i, w := range want {
+		got, err := gen()
+		if err != nil {
+			t.Fatalf("call %d: unexpected error: %v", i, err)
+		}
+		if got != w {
+			t.Errorf("call %d = %d, want %d", i, got, w)
+		}
+	}
+}
+
+func TestGeneratorReset(t *testing.T) {
+	g := NewGeneratorState()
+
+	for i := 0; i < 5; i++ {
+		if _, err := g.Next(); err != nil {
+			t.Fatalf("Next(): unexpected error: %v", err)
+		}
+	}
+
+	g.Reset()
+
+	got, err := g.Next()
+	if err != nil {
+		t.Fatalf("Next() after Reset(): unexpected error: %v", err)
+	}
+	if got != 0 {
+		t.Errorf("Next() after Reset() = %d, want 0", got)
+	}
+}
+
+func TestGeneratorOverflow(t *testing.T) {
+	// F(92) is the last term that fits in a signed 64-bit int; F(93)
+	// overflows.
+	g := NewGeneratorState()
+
+	var err error
+	for i := 0; i <= 92; i++ {
+		if _, err = g.Next(); err != nil {
+			t.Fatalf("Next() unexpectedly errored at term %d: %v", i, err)
+		}
+	}
+
+	if _, err = g.Next(); err == nil {
+		t.Fatal("Next() at term 93 = nil error, want overflow error")
+	}
+}
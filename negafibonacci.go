@@ -0,0 +1,143 @@
+package fib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// SignedN calculates the nth Fibonacci number for any integer n, including
+// negative indices, using the negafibonacci identity:
+//
+//	F(−k) = (−1)^(k+1) · F(k)
+//
+// Unlike fibonacci, which rejects negative n, SignedN computes |F(n)| via
+// the normal recurrence and flips the sign when n is a negative even
+// number.
+func SignedN(n int) (int, error) {
+	if n >= 0 {
+		return fibonacci(n)
+	}
+
+	k := -n
+	f, err := fibonacci(k)
+	if err != nil {
+		return 0, err
+	}
+
+	if k%2 == 0 {
+		f = -f
+	}
+	return f, nil
+}
+
+// BigSignedN is the math/big counterpart of SignedN, unbounded by int
+// overflow.
+func BigSignedN(n int) (*big.Int, error) {
+	if n >= 0 {
+		return BigN(n)
+	}
+
+	k := -n
+	f, err := BigN(k)
+	if err != nil {
+		return nil, err
+	}
+
+	if k%2 == 0 {
+		f.Neg(f)
+	}
+	return f, nil
+}
+
+// FastSignedN is the fast-doubling counterpart of SignedN: it supports
+// negative n via the negafibonacci identity while still running in
+// O(log |n|) big.Int multiplications, making it the preferred choice for
+// large |n|.
+func FastSignedN(n int) (*big.Int, error) {
+	if n >= 0 {
+		return FastN(n)
+	}
+
+	k := -n
+	f, err := FastN(k)
+	if err != nil {
+		return nil, err
+	}
+
+	if k%2 == 0 {
+		f.Neg(f)
+	}
+	return f, nil
+}
+
+// SignedSeq returns n consecutive Fibonacci numbers starting at index
+// start, [F(start), F(start+1), …, F(start+n-1)], where start may be
+// negative. The recurrence F(k+1) = F(k) + F(k-1) holds across the zero
+// boundary, so only the first one or two terms are computed via SignedN;
+// the rest share that running pair, giving O(|start| + n) total work rather
+// than O(n·|start|) from calling SignedN per index.
+func SignedSeq(start, n int) ([]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative length")
+	}
+
+	out := make([]int, 0, n)
+	if n == 0 {
+		return out, nil
+	}
+
+	a, err := SignedN(start)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, a)
+	if n == 1 {
+		return out, nil
+	}
+
+	b, err := SignedN(start + 1)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, b)
+
+	for i := 2; i < n; i++ {
+		a, b = b, a+b
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// BigSignedSeq is the math/big counterpart of SignedSeq, unbounded by int
+// overflow.
+func BigSignedSeq(start, n int) ([]*big.Int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative length")
+	}
+
+	out := make([]*big.Int, 0, n)
+	if n == 0 {
+		return out, nil
+	}
+
+	a, err := BigSignedN(start)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, a)
+	if n == 1 {
+		return out, nil
+	}
+
+	b, err := BigSignedN(start + 1)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, b)
+
+	for i := 2; i < n; i++ {
+		a, b = b, new(big.Int).Add(a, b)
+		out = append(out, b)
+	}
+	return out, nil
+}
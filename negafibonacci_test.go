@@ -0,0 +1,100 @@
+package fib
+
+import "testing"
+
+func TestSignedN(t *testing.T) {
+	// Expected F(n) for n in [−10, 10]; the negative indices alternate in
+	// sign per the negafibonacci identity.
+	want := map[int]int{
+		-10: -55, -9: 34, -8: -21, -7: 13, -6: -8, -5: 5, -4: -3, -3: 2,
+		-2: -1, -1: 1, 0: 0, 1: 1, 2: 1, 3: 2, 4: 3, 5: 5, 6: 8, 7: 13,
+		8: 21, 9: 34, 10: 55,
+	}
+
+	for n, want := range want {
+		got, err := SignedN(n)
+		if err != nil {
+			t.Fatalf("SignedN(%d): unexpected error: %v", n, err)
+		}
+		if got != want {
+			t.Errorf("SignedN(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestBigSignedN(t *testing.T) {
+	for n := -10; n <= 10; n++ {
+		want, err := SignedN(n)
+		if err != nil {
+			t.Fatalf("SignedN(%d): unexpected error: %v", n, err)
+		}
+
+		got, err := BigSignedN(n)
+		if err != nil {
+			t.Fatalf("BigSignedN(%d): unexpected error: %v", n, err)
+		}
+		if got.Int64() != int64(want) {
+			t.Errorf("BigSignedN(%d) = %s, want %d", n, got.String(), want)
+		}
+	}
+}
+
+func TestFastSignedN(t *testing.T) {
+	for n := -10; n <= 10; n++ {
+		want, err := SignedN(n)
+		if err != nil {
+			t.Fatalf("SignedN(%d): unexpected error: %v", n, err)
+		}
+
+		got, err := FastSignedN(n)
+		if err != nil {
+			t.Fatalf("FastSignedN(%d): unexpected error: %v", n, err)
+		}
+		if got.Int64() != int64(want) {
+			t.Errorf("FastSignedN(%d) = %s, want %d", n, got.String(), want)
+		}
+	}
+}
+
+func TestSignedSeq(t *testing.T) {
+	got, err := SignedSeq(-5, 11)
+	if err != nil {
+		t.Fatalf("SignedSeq(-5, 11): unexpected error: %v", err)
+	}
+
+	want := []int{5, -3, 2, -1, 1, 0, 1, 1, 2, 3, 5}
+	if len(got) != len(want) {
+		t.Fatalf("SignedSeq(-5, 11) returned %d terms, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("SignedSeq(-5, 11)[%d] = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSignedSeqNegativeLength(t *testing.T) {
+	if _, err := SignedSeq(-3, -1); err == nil {
+		t.Error("SignedSeq(-3, -1) = nil error, want error")
+	}
+}
+
+func TestBigSignedSeq(t *testing.T) {
+	want, err := SignedSeq(-5, 11)
+	if err != nil {
+		t.Fatalf("SignedSeq(-5, 11): unexpected error: %v", err)
+	}
+
+	got, err := BigSignedSeq(-5, 11)
+	if err != nil {
+		t.Fatalf("BigSignedSeq(-5, 11): unexpected error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("BigSignedSeq(-5, 11) returned %d terms, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Int64() != int64(want[i]) {
+			t.Errorf("BigSignedSeq(-5, 11)[%d] = %s, want %d", i, got[i].String(), want[i])
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package fib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Seq returns the first n Fibonacci numbers, [F(0), F(1), …, F(n-1)], in a
+// single O(n) pass. Like fibonacci, it does not check for int overflow:
+// terms past F(92) silently wrap. Use BigSeq if n may reach that range.
+func Seq(n int) ([]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative index")
+	}
+
+	out := make([]int, 0, n)
+	a, b := 0, 1
+	for i := 0; i < n; i++ {
+		out = append(out, a)
+		a, b = b, a+b
+	}
+
+	return out, nil
+}
+
+// BigSeq returns the first n Fibonacci numbers as *big.Int, [F(0), F(1), …,
+// F(n-1)], in a single O(n) pass, unbounded by int overflow.
+func BigSeq(n int) ([]*big.Int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("negative index")
+	}
+
+	out := make([]*big.Int, 0, n)
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		out = append(out, a)
+		a, b = b, new(big.Int).Add(a, b)
+	}
+
+	return out, nil
+}
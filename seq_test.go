@@ -0,0 +1,81 @@
+package fib
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	got, err := Seq(7)
+	if err != nil {
+		t.Fatalf("Seq(7): unexpected error: %v", err)
+	}
+	want := []int{0, 1, 1, 2, 3, 5, 8}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Seq(7) = %v, want %v", got, want)
+	}
+}
+
+func TestSeqZero(t *testing.T) {
+	got, err := Seq(0)
+	if err != nil {
+		t.Fatalf("Seq(0): unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Seq(0) = %v, want empty slice", got)
+	}
+}
+
+func TestSeqNegative(t *testing.T) {
+	if _, err := Seq(-1); err == nil {
+		t.Error("Seq(-1) = nil error, want error")
+	}
+}
+
+func TestSeqOverflow(t *testing.T) {
+	// F(93) overflows int, but Seq doesn't check for it (fibonacci
+	// doesn't either): it silently wraps.
+	got, err := Seq(94)
+	if err != nil {
+		t.Fatalf("Seq(94): unexpected error: %v", err)
+	}
+	if len(got) != 94 {
+		t.Fatalf("Seq(94) returned %d terms, want 94", len(got))
+	}
+	if got[92] != 7540113804746346429 {
+		t.Errorf("Seq(94)[92] = %d, want F(92) = 7540113804746346429", got[92])
+	}
+}
+
+func TestBigSeq(t *testing.T) {
+	got, err := BigSeq(7)
+	if err != nil {
+		t.Fatalf("BigSeq(7): unexpected error: %v", err)
+	}
+	want := []int64{0, 1, 1, 2, 3, 5, 8}
+	if len(got) != len(want) {
+		t.Fatalf("BigSeq(7) returned %d terms, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Cmp(big.NewInt(w)) != 0 {
+			t.Errorf("BigSeq(7)[%d] = %s, want %d", i, got[i].String(), w)
+		}
+	}
+}
+
+func TestBigSeqZero(t *testing.T) {
+	got, err := BigSeq(0)
+	if err != nil {
+		t.Fatalf("BigSeq(0): unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("BigSeq(0) = %v, want empty slice", got)
+	}
+}
+
+func TestBigSeqNegative(t *testing.T) {
+	if _, err := BigSeq(-1); err == nil {
+		t.Error("BigSeq(-1) = nil error, want error")
+	}
+}